@@ -0,0 +1,26 @@
+package dynamodb
+
+// Attribute is a single DynamoDB item attribute. Value holds the scalar
+// representation for S/N/B attributes; SetValue holds the member list for
+// SS/NS/BS attributes.
+type Attribute struct {
+	Type     string // "S", "N", "B", "BOOL", "NULL", "L", "M", "SS", "NS", "BS"
+	Name     string
+	Value    string
+	SetValue []string
+}
+
+// NewStringAttribute returns a new "S" attribute.
+func NewStringAttribute(name, value string) *Attribute {
+	return &Attribute{Type: "S", Name: name, Value: value}
+}
+
+// NewNumericAttribute returns a new "N" attribute.
+func NewNumericAttribute(name, value string) *Attribute {
+	return &Attribute{Type: "N", Name: name, Value: value}
+}
+
+// NewBinaryAttribute returns a new "B" attribute.
+func NewBinaryAttribute(name, value string) *Attribute {
+	return &Attribute{Type: "B", Name: name, Value: value}
+}
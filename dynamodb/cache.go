@@ -0,0 +1,314 @@
+package dynamodb
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cacher is a pluggable read-through cache backend for CachedTable. A
+// key's absence from the cache, or expiry of its TTL, must make Get
+// report ok == false.
+type Cacher interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+	Invalidate(key string)
+}
+
+// CachedTable wraps a *Table and short-circuits read operations through a
+// Cacher, transparently invalidating on writes to the same item. It is
+// meant for read-heavy workloads that want DAX-style latency and cost
+// savings without standing up a separate cluster.
+type CachedTable struct {
+	*Table
+	cache Cacher
+	ttl   time.Duration
+}
+
+// NewCachedTable wraps table so that GetItem, BatchGetItem and
+// consistent-read-false Query/Scan results are served through cache, with
+// the given default ttl used for entries populated by this CachedTable.
+func NewCachedTable(table *Table, cache Cacher, ttl time.Duration) *CachedTable {
+	return &CachedTable{Table: table, cache: cache, ttl: ttl}
+}
+
+func (ct *CachedTable) GetItem(hashKey, rangeKey string) (map[string]*Attribute, error) {
+	key := ct.itemCacheKey(hashKey, rangeKey)
+
+	if cached, ok := ct.cache.Get(key); ok {
+		var item map[string]*Attribute
+		if err := json.Unmarshal(cached, &item); err == nil {
+			return item, nil
+		}
+	}
+
+	item, err := ct.Table.GetItem(hashKey, rangeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ct.store(key, item)
+	return item, nil
+}
+
+// BatchGetItem returns one item per entry in keys, in the same order, so
+// callers can index the result with the keys they passed in. Cache hits are
+// served locally; the remaining misses are fetched in a single round trip
+// through the underlying table's BatchGetItem and matched back to their
+// requested key by reading the key attributes out of each returned item,
+// since DynamoDB's BatchGetItem makes no guarantee that results come back
+// in request order, or at all for keys that don't exist.
+func (ct *CachedTable) BatchGetItem(keys [][2]string) ([]map[string]*Attribute, error) {
+	items := make([]map[string]*Attribute, len(keys))
+	indexByCacheKey := make(map[string]int, len(keys))
+	var missKeys [][2]string
+
+	for i, k := range keys {
+		cacheKey := ct.itemCacheKey(k[0], k[1])
+		if cached, ok := ct.cache.Get(cacheKey); ok {
+			var item map[string]*Attribute
+			if err := json.Unmarshal(cached, &item); err == nil {
+				items[i] = item
+				continue
+			}
+		}
+		indexByCacheKey[cacheKey] = i
+		missKeys = append(missKeys, k)
+	}
+
+	if len(missKeys) == 0 {
+		return items, nil
+	}
+
+	fetched, err := ct.Table.BatchGetItem(missKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range fetched {
+		hashKey, rangeKey, ok := ct.itemKeyValues(item)
+		if !ok {
+			continue
+		}
+
+		cacheKey := ct.itemCacheKey(hashKey, rangeKey)
+		idx, ok := indexByCacheKey[cacheKey]
+		if !ok {
+			continue
+		}
+
+		items[idx] = item
+		ct.store(cacheKey, item)
+	}
+
+	return items, nil
+}
+
+// itemKeyValues reads the hash (and, for composite keys, range) attribute
+// values back out of item, so a batch result can be matched to the request
+// key it answers regardless of the order BatchGetItem returned it in.
+func (ct *CachedTable) itemKeyValues(item map[string]*Attribute) (hashKey, rangeKey string, ok bool) {
+	hash, present := item[ct.Table.Key.KeyAttribute.Name]
+	if !present {
+		return "", "", false
+	}
+	hashKey = hash.Value
+
+	if ct.Table.Key.RangeAttribute == nil {
+		return hashKey, "", true
+	}
+
+	rng, present := item[ct.Table.Key.RangeAttribute.Name]
+	if !present {
+		return "", "", false
+	}
+	return hashKey, rng.Value, true
+}
+
+func (ct *CachedTable) Query(attributeComparisons []AttributeComparison, consistentRead bool) ([]map[string]*Attribute, error) {
+	if consistentRead {
+		return ct.Table.Query(attributeComparisons, consistentRead)
+	}
+
+	key := ct.queryCacheKey("query", attributeComparisons)
+	if cached, ok := ct.cache.Get(key); ok {
+		var items []map[string]*Attribute
+		if err := json.Unmarshal(cached, &items); err == nil {
+			return items, nil
+		}
+	}
+
+	items, err := ct.Table.Query(attributeComparisons, consistentRead)
+	if err != nil {
+		return nil, err
+	}
+
+	ct.store(key, items)
+	return items, nil
+}
+
+func (ct *CachedTable) Scan(attributeComparisons []AttributeComparison, consistentRead bool) ([]map[string]*Attribute, error) {
+	if consistentRead {
+		return ct.Table.Scan(attributeComparisons, consistentRead)
+	}
+
+	key := ct.queryCacheKey("scan", attributeComparisons)
+	if cached, ok := ct.cache.Get(key); ok {
+		var items []map[string]*Attribute
+		if err := json.Unmarshal(cached, &items); err == nil {
+			return items, nil
+		}
+	}
+
+	items, err := ct.Table.Scan(attributeComparisons, consistentRead)
+	if err != nil {
+		return nil, err
+	}
+
+	ct.store(key, items)
+	return items, nil
+}
+
+func (ct *CachedTable) PutItem(hashKey, rangeKey string, attributes []Attribute) (bool, error) {
+	ok, err := ct.Table.PutItem(hashKey, rangeKey, attributes)
+	if err == nil {
+		ct.cache.Invalidate(ct.itemCacheKey(hashKey, rangeKey))
+	}
+	return ok, err
+}
+
+func (ct *CachedTable) UpdateItem(hashKey, rangeKey string, attributes []Attribute) (bool, error) {
+	ok, err := ct.Table.UpdateItem(hashKey, rangeKey, attributes)
+	if err == nil {
+		ct.cache.Invalidate(ct.itemCacheKey(hashKey, rangeKey))
+	}
+	return ok, err
+}
+
+func (ct *CachedTable) DeleteItem(hashKey, rangeKey string) (bool, error) {
+	ok, err := ct.Table.DeleteItem(hashKey, rangeKey)
+	if err == nil {
+		ct.cache.Invalidate(ct.itemCacheKey(hashKey, rangeKey))
+	}
+	return ok, err
+}
+
+func (ct *CachedTable) store(key string, value interface{}) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	ct.cache.Set(key, encoded, ct.ttl)
+}
+
+// itemCacheKey canonicalizes the primary key attribute names and values so
+// hash-only and hash+range tables both produce a stable key.
+func (ct *CachedTable) itemCacheKey(hashKey, rangeKey string) string {
+	if ct.Table.Key.RangeAttribute == nil {
+		return fmt.Sprintf("%s/item/%s=%s", ct.Table.Name, ct.Table.Key.KeyAttribute.Name, hashKey)
+	}
+	return fmt.Sprintf("%s/item/%s=%s/%s=%s", ct.Table.Name,
+		ct.Table.Key.KeyAttribute.Name, hashKey,
+		ct.Table.Key.RangeAttribute.Name, rangeKey)
+}
+
+func (ct *CachedTable) queryCacheKey(op string, attributeComparisons []AttributeComparison) string {
+	parts := make([]string, len(attributeComparisons))
+	for i, c := range attributeComparisons {
+		parts[i] = fmt.Sprintf("%s%s%v", c.AttributeName, c.ComparisonOperator, c.AttributeValueList)
+	}
+	sort.Strings(parts)
+	return fmt.Sprintf("%s/%s/%s", ct.Table.Name, op, strings.Join(parts, "&"))
+}
+
+// LRUCache is an in-memory, size-bounded Cacher with a default TTL applied
+// to entries whose caller didn't request one.
+type LRUCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	defaultTTL time.Duration
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLRUCache returns an in-memory LRU Cacher holding at most maxEntries
+// entries, evicting the least recently used one once full.
+func NewLRUCache(maxEntries int, defaultTTL time.Duration) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		defaultTTL: defaultTTL,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*lruEntry)
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		c.ll.Remove(el)
+		delete(c.items, key)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	return entry.value, true
+}
+
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if ttl == 0 {
+		ttl = c.defaultTTL
+	}
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*lruEntry).value = value
+		el.Value.(*lruEntry).expiresAt = expiresAt
+		return
+	}
+
+	el := c.ll.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	c.items[key] = el
+
+	if c.maxEntries > 0 && c.ll.Len() > c.maxEntries {
+		oldest := c.ll.Back()
+		if oldest != nil {
+			c.ll.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruEntry).key)
+		}
+	}
+}
+
+func (c *LRUCache) Invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.Remove(el)
+		delete(c.items, key)
+	}
+}
@@ -0,0 +1,114 @@
+package dynamodb
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRUCache(2, time.Minute)
+
+	c.Set("a", []byte("1"), 0)
+	c.Set("b", []byte("2"), 0)
+
+	// Touch "a" so "b" becomes the least recently used entry.
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected a to be present")
+	}
+
+	c.Set("c", []byte("3"), 0)
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("expected b to have been evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected a to survive eviction")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Fatal("expected c to be present")
+	}
+}
+
+func TestLRUCacheTTLExpiry(t *testing.T) {
+	c := NewLRUCache(10, 0)
+
+	c.Set("a", []byte("1"), time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected a to have expired")
+	}
+}
+
+func TestLRUCacheDefaultTTL(t *testing.T) {
+	c := NewLRUCache(10, time.Millisecond)
+
+	c.Set("a", []byte("1"), 0)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected a to fall back to the default TTL and expire")
+	}
+}
+
+func TestLRUCacheInvalidate(t *testing.T) {
+	c := NewLRUCache(10, time.Minute)
+
+	c.Set("a", []byte("1"), 0)
+	c.Invalidate("a")
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected a to have been invalidated")
+	}
+}
+
+func TestCachedTableItemKeyValuesHashOnly(t *testing.T) {
+	ct := &CachedTable{Table: &Table{Key: PrimaryKey{KeyAttribute: NewStringAttribute("UserId", "")}}}
+
+	item := map[string]*Attribute{
+		"UserId": NewStringAttribute("UserId", "u-123"),
+	}
+
+	hashKey, rangeKey, ok := ct.itemKeyValues(item)
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if hashKey != "u-123" || rangeKey != "" {
+		t.Fatalf("got hashKey=%q rangeKey=%q", hashKey, rangeKey)
+	}
+}
+
+func TestCachedTableItemKeyValuesComposite(t *testing.T) {
+	ct := &CachedTable{Table: &Table{Key: PrimaryKey{
+		KeyAttribute:   NewStringAttribute("UserId", ""),
+		RangeAttribute: NewStringAttribute("OSType", ""),
+	}}}
+
+	item := map[string]*Attribute{
+		"UserId": NewStringAttribute("UserId", "u-123"),
+		"OSType": NewStringAttribute("OSType", "linux"),
+	}
+
+	hashKey, rangeKey, ok := ct.itemKeyValues(item)
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if hashKey != "u-123" || rangeKey != "linux" {
+		t.Fatalf("got hashKey=%q rangeKey=%q", hashKey, rangeKey)
+	}
+}
+
+func TestCachedTableItemKeyValuesMissingRangeAttribute(t *testing.T) {
+	ct := &CachedTable{Table: &Table{Key: PrimaryKey{
+		KeyAttribute:   NewStringAttribute("UserId", ""),
+		RangeAttribute: NewStringAttribute("OSType", ""),
+	}}}
+
+	item := map[string]*Attribute{
+		"UserId": NewStringAttribute("UserId", "u-123"),
+	}
+
+	if _, _, ok := ct.itemKeyValues(item); ok {
+		t.Fatal("expected no match when the range attribute is absent from the item")
+	}
+}
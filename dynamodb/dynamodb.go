@@ -0,0 +1,151 @@
+package dynamodb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rightscale/goamz/aws"
+)
+
+const dynamoDBTargetPrefix = "DynamoDB_20120810."
+
+// Server is a DynamoDB client bound to a specific account and region.
+type Server struct {
+	Auth   aws.Auth
+	Region aws.Region
+}
+
+// New returns a Server for the given credentials and region.
+func New(auth aws.Auth, region aws.Region) *Server {
+	return &Server{auth, region}
+}
+
+// target returns the X-Amz-Target header value for a DynamoDB operation
+// name, e.g. target("CreateTable") -> "DynamoDB_20120810.CreateTable".
+func target(name string) string {
+	return dynamoDBTargetPrefix + name
+}
+
+// queryServer issues query against amzTarget and returns the raw JSON
+// response body.
+func (s *Server) queryServer(amzTarget string, query *Query) ([]byte, error) {
+	return s.queryServerWithContext(context.Background(), amzTarget, query)
+}
+
+// queryServerWithContext issues query against amzTarget, threading ctx into
+// the underlying HTTP request itself (via http.Request.WithContext) so that
+// a cancelled or timed-out ctx aborts the request in flight instead of
+// merely abandoning a goroutine that keeps running - and mutating - in the
+// background.
+func (s *Server) queryServerWithContext(ctx context.Context, amzTarget string, query *Query) ([]byte, error) {
+	data := query.String()
+
+	hreq, err := http.NewRequest("POST", s.Region.DynamoDBEndpoint, bytes.NewReader([]byte(data)))
+	if err != nil {
+		return nil, err
+	}
+	hreq = hreq.WithContext(ctx)
+
+	hreq.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	hreq.Header.Set("X-Amz-Target", amzTarget)
+
+	signer := aws.NewV4Signer(s.Auth, "dynamodb", s.Region)
+	signer.Sign(hreq)
+
+	resp, err := http.DefaultClient.Do(hreq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newError(resp, body)
+	}
+
+	return body, nil
+}
+
+type errorResponse struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+// newError builds an *Error from a non-200 DynamoDB response, capturing
+// whatever Retry-After delay the server sent along with it.
+func newError(resp *http.Response, body []byte) *Error {
+	var er errorResponse
+	json.Unmarshal(body, &er)
+
+	code := er.Type
+	if idx := strings.LastIndex(code, "#"); idx >= 0 {
+		code = code[idx+1:]
+	}
+
+	e := &Error{
+		StatusCode: resp.StatusCode,
+		Code:       code,
+		Message:    er.Message,
+	}
+
+	if ra := resp.Header.Get("Retry-After"); ra != "" {
+		if d, ok := parseRetryAfter(ra); ok {
+			e.retryAfter = d
+			e.hasRetryAfter = true
+		}
+	}
+
+	return e
+}
+
+// parseRetryAfter parses a Retry-After header value, which per RFC 7231 is
+// either a number of seconds or an HTTP-date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if secs, err := strconv.Atoi(header); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(header); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// Error represents an error response returned by DynamoDB.
+type Error struct {
+	StatusCode int    // HTTP status code, e.g. 400
+	Code       string // DynamoDB exception name, e.g. "ThrottlingException"
+	Message    string
+
+	retryAfter    time.Duration
+	hasRetryAfter bool
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (%d): %s", e.Code, e.StatusCode, e.Message)
+}
+
+// RetryAfter reports the Retry-After delay DynamoDB sent with this error, if
+// any.
+func (e *Error) RetryAfter() (time.Duration, bool) {
+	return e.retryAfter, e.hasRetryAfter
+}
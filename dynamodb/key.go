@@ -0,0 +1,16 @@
+package dynamodb
+
+// PrimaryKey identifies the hash (and, for composite keys, range) attribute
+// of a table or index. RangeAttribute is nil for hash-only keys.
+type PrimaryKey struct {
+	KeyAttribute   *Attribute
+	RangeAttribute *Attribute
+}
+
+// AttributeComparison is a single condition within a Query or Scan, e.g.
+// {"UserId", "EQ", []Attribute{*NewStringAttribute("", "u-123")}}.
+type AttributeComparison struct {
+	AttributeName      string
+	ComparisonOperator string
+	AttributeValueList []Attribute
+}
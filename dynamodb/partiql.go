@@ -0,0 +1,93 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// ExecuteStatementResultT is the decoded response of an ExecuteStatement
+// request.
+type ExecuteStatementResultT struct {
+	Items     []map[string]*Attribute
+	NextToken string
+}
+
+// BatchStatementRequestT is a single PartiQL statement within a
+// BatchExecuteStatement call.
+type BatchStatementRequestT struct {
+	Statement      string
+	Parameters     []Attribute
+	ConsistentRead bool
+}
+
+// BatchStatementResponseT is the per-statement result of a
+// BatchExecuteStatement call.
+type BatchStatementResponseT struct {
+	Error     *BatchStatementErrorT
+	Item      map[string]*Attribute
+	TableName string
+}
+
+// BatchStatementErrorT describes why a single statement within a
+// BatchExecuteStatement request failed.
+type BatchStatementErrorT struct {
+	Code    string
+	Message string
+}
+
+type executeStatementResponse struct {
+	Items     []map[string]*Attribute
+	NextToken string
+}
+
+type batchExecuteStatementResponse struct {
+	Responses []BatchStatementResponseT
+}
+
+// ExecuteStatement runs a single PartiQL statement against the table(s) it
+// references, optionally continuing from a previous NextToken.
+func (s *Server) ExecuteStatement(stmt string, params []Attribute, consistentRead bool, nextToken string) (*ExecuteStatementResultT, error) {
+	return s.ExecuteStatementWithContext(context.Background(), stmt, params, consistentRead, nextToken)
+}
+
+func (s *Server) ExecuteStatementWithContext(ctx context.Context, stmt string, params []Attribute, consistentRead bool, nextToken string) (*ExecuteStatementResultT, error) {
+	query := NewEmptyQuery()
+	query.AddExecuteStatement(stmt, params, consistentRead, nextToken)
+
+	jsonResponse, err := s.queryServerWithContext(ctx, target("ExecuteStatement"), query)
+	if err != nil {
+		return nil, err
+	}
+
+	var r executeStatementResponse
+	err = json.Unmarshal(jsonResponse, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExecuteStatementResultT{Items: r.Items, NextToken: r.NextToken}, nil
+}
+
+// BatchExecuteStatement runs a batch of PartiQL statements in a single
+// round-trip, returning one response per statement in the same order.
+func (s *Server) BatchExecuteStatement(statements []BatchStatementRequestT) ([]BatchStatementResponseT, error) {
+	return s.BatchExecuteStatementWithContext(context.Background(), statements)
+}
+
+func (s *Server) BatchExecuteStatementWithContext(ctx context.Context, statements []BatchStatementRequestT) ([]BatchStatementResponseT, error) {
+	query := NewEmptyQuery()
+	query.AddBatchExecuteStatement(statements)
+
+	jsonResponse, err := s.queryServerWithContext(ctx, target("BatchExecuteStatement"), query)
+	if err != nil {
+		return nil, err
+	}
+
+	var r batchExecuteStatementResponse
+	err = json.Unmarshal(jsonResponse, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Responses, nil
+}
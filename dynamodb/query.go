@@ -0,0 +1,200 @@
+package dynamodb
+
+import (
+	simplejson "github.com/bitly/go-simplejson"
+)
+
+// Query builds up the JSON request body for a single DynamoDB operation.
+type Query struct {
+	json *simplejson.Json
+}
+
+// NewEmptyQuery returns a Query with an empty JSON object body.
+func NewEmptyQuery() *Query {
+	return &Query{json: simplejson.New()}
+}
+
+// String renders the accumulated request body as JSON.
+func (q *Query) String() string {
+	b, err := q.json.MarshalJSON()
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+func (q *Query) addTableByName(name string) {
+	q.json.Set("TableName", name)
+}
+
+// AddCreateRequestTable builds a CreateTable request body from t.
+// ProvisionedThroughput is omitted from the table and every GSI when t is
+// billed PAY_PER_REQUEST, since DynamoDB rejects CreateTable requests that
+// supply both.
+func (q *Query) AddCreateRequestTable(t TableDescriptionT) {
+	q.addTableDescription(t)
+}
+
+// AddUpdateRequestTable builds an UpdateTable request body from t, applying
+// the same PAY_PER_REQUEST omission as AddCreateRequestTable.
+func (q *Query) AddUpdateRequestTable(t TableDescriptionT) {
+	q.addTableDescription(t)
+}
+
+// AddDeleteRequestTable builds a DeleteTable request body from t.
+func (q *Query) AddDeleteRequestTable(t TableDescriptionT) {
+	q.json.Set("TableName", t.TableName)
+}
+
+func (q *Query) addTableDescription(t TableDescriptionT) {
+	onDemand := t.BillingMode == "PAY_PER_REQUEST"
+
+	q.json.Set("TableName", t.TableName)
+
+	attributeDefinitions := make([]map[string]string, len(t.AttributeDefinitions))
+	for i, ad := range t.AttributeDefinitions {
+		attributeDefinitions[i] = map[string]string{
+			"AttributeName": ad.Name,
+			"AttributeType": ad.Type,
+		}
+	}
+	q.json.Set("AttributeDefinitions", attributeDefinitions)
+
+	keySchema := make([]map[string]string, len(t.KeySchema))
+	for i, k := range t.KeySchema {
+		keySchema[i] = map[string]string{
+			"AttributeName": k.AttributeName,
+			"KeyType":       k.KeyType,
+		}
+	}
+	q.json.Set("KeySchema", keySchema)
+
+	if t.BillingMode != "" {
+		q.json.Set("BillingMode", t.BillingMode)
+	}
+
+	if !onDemand {
+		q.json.Set("ProvisionedThroughput", provisionedThroughputRequest(t.ProvisionedThroughput))
+	}
+
+	if len(t.GlobalSecondaryIndexes) > 0 {
+		gsis := make([]map[string]interface{}, len(t.GlobalSecondaryIndexes))
+		for i, gsi := range t.GlobalSecondaryIndexes {
+			gsiKeySchema := make([]map[string]string, len(gsi.KeySchema))
+			for j, k := range gsi.KeySchema {
+				gsiKeySchema[j] = map[string]string{
+					"AttributeName": k.AttributeName,
+					"KeyType":       k.KeyType,
+				}
+			}
+
+			entry := map[string]interface{}{
+				"IndexName": gsi.IndexName,
+				"KeySchema": gsiKeySchema,
+				"Projection": map[string]interface{}{
+					"ProjectionType":   gsi.Projection.ProjectionType,
+					"NonKeyAttributes": gsi.Projection.NonKeyAttributes,
+				},
+			}
+			if !onDemand {
+				entry["ProvisionedThroughput"] = provisionedThroughputRequest(gsi.ProvisionedThroughput)
+			}
+			gsis[i] = entry
+		}
+		q.json.Set("GlobalSecondaryIndexes", gsis)
+	}
+}
+
+func provisionedThroughputRequest(pt ProvisionedThroughputT) map[string]int64 {
+	return map[string]int64{
+		"ReadCapacityUnits":  pt.ReadCapacityUnits,
+		"WriteCapacityUnits": pt.WriteCapacityUnits,
+	}
+}
+
+// AddUpdateTimeToLive builds an UpdateTimeToLive request body for the named
+// table.
+func (q *Query) AddUpdateTimeToLive(tableName string, spec TimeToLiveSpecificationT) {
+	q.json.Set("TableName", tableName)
+	q.json.Set("TimeToLiveSpecification", map[string]interface{}{
+		"AttributeName": spec.AttributeName,
+		"Enabled":       spec.Enabled,
+	})
+}
+
+// AddExecuteStatement builds an ExecuteStatement request body for a single
+// PartiQL statement.
+func (q *Query) AddExecuteStatement(stmt string, params []Attribute, consistentRead bool, nextToken string) {
+	q.json.Set("Statement", stmt)
+	if len(params) > 0 {
+		q.json.Set("Parameters", attributeValueList(params))
+	}
+	if consistentRead {
+		q.json.Set("ConsistentRead", consistentRead)
+	}
+	if nextToken != "" {
+		q.json.Set("NextToken", nextToken)
+	}
+}
+
+// AddBatchExecuteStatement builds a BatchExecuteStatement request body for a
+// batch of PartiQL statements.
+func (q *Query) AddBatchExecuteStatement(statements []BatchStatementRequestT) {
+	requests := make([]map[string]interface{}, len(statements))
+	for i, stmt := range statements {
+		entry := map[string]interface{}{
+			"Statement": stmt.Statement,
+		}
+		if len(stmt.Parameters) > 0 {
+			entry["Parameters"] = attributeValueList(stmt.Parameters)
+		}
+		if stmt.ConsistentRead {
+			entry["ConsistentRead"] = stmt.ConsistentRead
+		}
+		requests[i] = entry
+	}
+	q.json.Set("Statements", requests)
+}
+
+func attributeValueList(attrs []Attribute) []map[string]string {
+	values := make([]map[string]string, len(attrs))
+	for i, a := range attrs {
+		values[i] = map[string]string{a.Type: a.Value}
+	}
+	return values
+}
+
+// AddTagResource builds a TagResource request body.
+func (q *Query) AddTagResource(resourceArn string, tags []TagT) {
+	q.json.Set("ResourceArn", resourceArn)
+
+	tagList := make([]map[string]string, len(tags))
+	for i, tag := range tags {
+		tagList[i] = map[string]string{"Key": tag.Key, "Value": tag.Value}
+	}
+	q.json.Set("Tags", tagList)
+}
+
+// AddUntagResource builds an UntagResource request body.
+func (q *Query) AddUntagResource(resourceArn string, tagKeys []string) {
+	q.json.Set("ResourceArn", resourceArn)
+	q.json.Set("TagKeys", tagKeys)
+}
+
+// AddListTagsOfResource builds a ListTagsOfResource request body for one
+// page of results, resuming from nextToken when it is non-empty.
+func (q *Query) AddListTagsOfResource(resourceArn string, nextToken string) {
+	q.json.Set("ResourceArn", resourceArn)
+	if nextToken != "" {
+		q.json.Set("NextToken", nextToken)
+	}
+}
+
+// AddExclusiveStartTableName sets the table to resume a paginated ListTables
+// call from. An empty name is a no-op, matching the first page of results.
+func (q *Query) AddExclusiveStartTableName(name string) {
+	if name == "" {
+		return
+	}
+	q.json.Set("ExclusiveStartTableName", name)
+}
@@ -0,0 +1,86 @@
+package dynamodb
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBackoffWithFullJitterBounds(t *testing.T) {
+	for attempt := uint(0); attempt < 10; attempt++ {
+		ceiling := defaultBaseDelay << attempt
+		if ceiling <= 0 || ceiling > defaultMaxDelay {
+			ceiling = defaultMaxDelay
+		}
+
+		for i := 0; i < 100; i++ {
+			delay := backoffWithFullJitter(attempt)
+			if delay < 0 || delay >= ceiling {
+				t.Fatalf("attempt %d: delay %v out of range [0, %v)", attempt, delay, ceiling)
+			}
+		}
+	}
+}
+
+func TestBackoffWithFullJitterCapsAtMaxDelay(t *testing.T) {
+	// A large attempt would overflow time.Duration<<attempt; make sure the
+	// result is still clamped to defaultMaxDelay instead of wrapping.
+	for _, attempt := range []uint{32, 63, math.MaxUint8} {
+		delay := backoffWithFullJitter(attempt)
+		if delay < 0 || delay >= defaultMaxDelay {
+			t.Fatalf("attempt %d: delay %v not capped to defaultMaxDelay %v", attempt, delay, defaultMaxDelay)
+		}
+	}
+}
+
+func TestIsRetryableErrorNetwork(t *testing.T) {
+	if isRetryableError(nil) {
+		t.Fatal("nil error should not be retryable")
+	}
+
+	timeoutErr := &timeoutNetError{}
+	if !isRetryableError(timeoutErr) {
+		t.Fatal("a timing-out net.Error should be retryable")
+	}
+}
+
+type timeoutNetError struct{}
+
+func (e *timeoutNetError) Error() string   { return "timeout" }
+func (e *timeoutNetError) Timeout() bool   { return true }
+func (e *timeoutNetError) Temporary() bool { return false }
+
+// retryAfterError is a *Error stand-in that always carries a server-
+// specified Retry-After delay.
+type retryAfterError struct {
+	*Error
+	delay time.Duration
+}
+
+func (e *retryAfterError) RetryAfter() (time.Duration, bool) { return e.delay, true }
+
+func TestExponentialBackoffRetryHonorsRetryAfter(t *testing.T) {
+	err := &retryAfterError{
+		Error: &Error{StatusCode: 503, Code: "ThrottlingException"},
+		delay: 10 * time.Millisecond,
+	}
+
+	start := time.Now()
+	calls := 0
+	ExponentialBackoffRetry{MaxAttempts: 2}.Retry(context.Background(), func(context.Context) error {
+		calls++
+		if calls == 1 {
+			return err
+		}
+		return nil
+	})
+	elapsed := time.Since(start)
+
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+	if elapsed < err.delay {
+		t.Fatalf("expected to wait at least the server's Retry-After delay %v, waited %v", err.delay, elapsed)
+	}
+}
@@ -1,10 +1,14 @@
 package dynamodb
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"math/rand"
+	"net"
 	"time"
 
 	simplejson "github.com/bitly/go-simplejson"
@@ -55,6 +59,16 @@ type ProvisionedThroughputT struct {
 	WriteCapacityUnits     int64
 }
 
+type BillingModeSummaryT struct {
+	BillingMode                       string
+	LastUpdateToPayPerRequestDateTime float64
+}
+
+type StreamSpecificationT struct {
+	StreamEnabled  bool
+	StreamViewType string // one of "KEYS_ONLY", "NEW_IMAGE", "OLD_IMAGE", "NEW_AND_OLD_IMAGES"
+}
+
 type TableDescriptionT struct {
 	AttributeDefinitions   []AttributeDefinitionT
 	CreationDateTime       float64
@@ -66,6 +80,12 @@ type TableDescriptionT struct {
 	TableName              string
 	TableSizeBytes         int64
 	TableStatus            string
+	BillingMode            string // "PROVISIONED" or "PAY_PER_REQUEST"
+	BillingModeSummary     BillingModeSummaryT
+	StreamSpecification    StreamSpecificationT
+	LatestStreamArn        string
+	LatestStreamLabel      string
+	TableArn               string
 }
 
 type describeTableResponse struct {
@@ -117,13 +137,17 @@ func (t *TableDescriptionT) BuildPrimaryKey() (pk PrimaryKey, err error) {
 }
 
 func (s *Server) NewTable(name string, key PrimaryKey) *Table {
-	return &Table{s, name, key, DefaultBasicRetry}
+	return &Table{s, name, key, DefaultExponentialBackoffRetry}
 }
 
 func (s *Server) ListTables() ([]string, error) {
+	return s.ListTablesWithContext(context.Background())
+}
+
+func (s *Server) ListTablesWithContext(ctx context.Context) ([]string, error) {
 	var tables []string
 
-	err := s.ListTablesCallbackIterator(
+	err := s.ListTablesCallbackIteratorWithContext(ctx,
 		func(t string) {
 			tables = append(tables, t)
 		},
@@ -133,13 +157,17 @@ func (s *Server) ListTables() ([]string, error) {
 }
 
 func (s *Server) ListTablesCallbackIterator(cb func(string)) error {
+	return s.ListTablesCallbackIteratorWithContext(context.Background(), cb)
+}
+
+func (s *Server) ListTablesCallbackIteratorWithContext(ctx context.Context, cb func(string)) error {
 	var lastEvaluatedTableName string
 
 	for {
 		query := NewEmptyQuery()
 		query.AddExclusiveStartTableName(lastEvaluatedTableName)
 
-		jsonResponse, err := s.queryServer(target("ListTables"), query)
+		jsonResponse, err := s.queryServerWithContext(ctx, target("ListTables"), query)
 		if err != nil {
 			return err
 		}
@@ -179,10 +207,39 @@ func (s *Server) ListTablesCallbackIterator(cb func(string)) error {
 }
 
 func (s *Server) CreateTable(tableDescription TableDescriptionT) (string, error) {
+	return s.CreateTableWithContext(context.Background(), tableDescription)
+}
+
+func (s *Server) CreateTableWithContext(ctx context.Context, tableDescription TableDescriptionT) (string, error) {
 	query := NewEmptyQuery()
 	query.AddCreateRequestTable(tableDescription)
 
-	jsonResponse, err := s.queryServer(target("CreateTable"), query)
+	jsonResponse, err := s.queryServerWithContext(ctx, target("CreateTable"), query)
+
+	if err != nil {
+		return "unknown", err
+	}
+
+	json, err := simplejson.NewJson(jsonResponse)
+
+	if err != nil {
+		return "unknown", err
+	}
+
+	return json.Get("TableDescription").Get("TableStatus").MustString(), nil
+}
+
+// UpdateTable changes a table's throughput, billing mode, or global
+// secondary indexes to match tableDescription.
+func (s *Server) UpdateTable(tableDescription TableDescriptionT) (string, error) {
+	return s.UpdateTableWithContext(context.Background(), tableDescription)
+}
+
+func (s *Server) UpdateTableWithContext(ctx context.Context, tableDescription TableDescriptionT) (string, error) {
+	query := NewEmptyQuery()
+	query.AddUpdateRequestTable(tableDescription)
+
+	jsonResponse, err := s.queryServerWithContext(ctx, target("UpdateTable"), query)
 
 	if err != nil {
 		return "unknown", err
@@ -198,10 +255,14 @@ func (s *Server) CreateTable(tableDescription TableDescriptionT) (string, error)
 }
 
 func (s *Server) DeleteTable(tableDescription TableDescriptionT) (string, error) {
+	return s.DeleteTableWithContext(context.Background(), tableDescription)
+}
+
+func (s *Server) DeleteTableWithContext(ctx context.Context, tableDescription TableDescriptionT) (string, error) {
 	query := NewEmptyQuery()
 	query.AddDeleteRequestTable(tableDescription)
 
-	jsonResponse, err := s.queryServer(target("DeleteTable"), query)
+	jsonResponse, err := s.queryServerWithContext(ctx, target("DeleteTable"), query)
 
 	if err != nil {
 		return "unknown", err
@@ -220,11 +281,29 @@ func (t *Table) DescribeTable() (*TableDescriptionT, error) {
 	return t.Server.DescribeTable(t.Name)
 }
 
+func (t *Table) DescribeTableWithContext(ctx context.Context) (*TableDescriptionT, error) {
+	return t.Server.DescribeTableWithContext(ctx, t.Name)
+}
+
+// LatestStreamArn fetches the table description and returns the ARN of its
+// most recently enabled DynamoDB Stream, or "" if streams aren't enabled.
+func (t *Table) LatestStreamArn() (string, error) {
+	description, err := t.DescribeTable()
+	if err != nil {
+		return "", err
+	}
+	return description.LatestStreamArn, nil
+}
+
 func (s *Server) DescribeTable(name string) (*TableDescriptionT, error) {
+	return s.DescribeTableWithContext(context.Background(), name)
+}
+
+func (s *Server) DescribeTableWithContext(ctx context.Context, name string) (*TableDescriptionT, error) {
 	q := NewEmptyQuery()
 	q.addTableByName(name)
 
-	jsonResponse, err := s.queryServer(target("DescribeTable"), q)
+	jsonResponse, err := s.queryServerWithContext(ctx, target("DescribeTable"), q)
 	if err != nil {
 		return nil, err
 	}
@@ -261,57 +340,147 @@ func keyValue(key string, value string) string {
 
 const maxNumberOfRetry = 4
 
+// defaultBaseDelay and defaultMaxDelay bound the jittered exponential
+// backoff used by ExponentialBackoffRetry: base=50ms, cap=20s per the
+// "Exponential Backoff and Jitter" pattern.
+const defaultBaseDelay = 50 * time.Millisecond
+const defaultMaxDelay = 20 * time.Second
+
 var DefaultBasicRetry = BasicRetry{}
 var DefaultSkipRetry = SkipRetry{}
 
+// DefaultExponentialBackoffRetry matches the old BasicRetry budget of 4
+// retries (5 calls total: MaxAttempts counts the initial call too).
+var DefaultExponentialBackoffRetry = ExponentialBackoffRetry{
+	MaxAttempts: maxNumberOfRetry + 1,
+	MaxElapsed:  2 * time.Minute,
+}
+
 // Interface ==========
 
 type RetryHandlerInterface interface {
-	Retry(exec func() error)
+	Retry(ctx context.Context, exec func(context.Context) error)
 }
 
 func (t *Table) SetRetryHandler(rhi RetryHandlerInterface) {
 	t.RetryHandler = rhi
 }
 
-// BasicRetry ==========
+// retryAfterDelayer is implemented by errors that can report a server-
+// specified delay to wait before retrying, such as a DynamoDB response
+// carrying a Retry-After header.
+type retryAfterDelayer interface {
+	RetryAfter() (time.Duration, bool)
+}
 
-type BasicRetry struct{}
+// ExponentialBackoffRetry ==========
+
+// ExponentialBackoffRetry retries exec with full jitter exponential backoff,
+// bounded by a retry budget of MaxAttempts and MaxElapsed wall time. It
+// classifies throttling, 5xx and transient network errors as retryable.
+type ExponentialBackoffRetry struct {
+	// MaxAttempts is the maximum number of calls to exec, including the
+	// first. Zero means unlimited attempts.
+	MaxAttempts uint
+	// MaxElapsed bounds the total wall time spent retrying. Zero means
+	// unlimited.
+	MaxElapsed time.Duration
+}
 
-func (br BasicRetry) Retry(exec func() error) {
-	// based on: http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/ErrorHandling.html#APIRetries
-	currentRetry := uint(0)
-	for {
-		err := exec()
-		if currentRetry >= maxNumberOfRetry {
-			break
+func (br ExponentialBackoffRetry) Retry(ctx context.Context, exec func(context.Context) error) {
+	start := time.Now()
+	for attempt := uint(0); ; attempt++ {
+		err := exec(ctx)
+		if err == nil {
+			return
+		}
+		if !isRetryableError(err) {
+			return
+		}
+		if br.MaxAttempts > 0 && attempt+1 >= br.MaxAttempts {
+			return
+		}
+		if br.MaxElapsed > 0 && time.Since(start) >= br.MaxElapsed {
+			return
 		}
 
-		retry := false
-		if err != nil {
-			log.Printf("Error requesting from Amazon: %v", err)
-
-			if err, ok := err.(*Error); ok {
-				retry = (err.StatusCode == 500) ||
-					(err.Code == "ThrottlingException") ||
-					(err.Code == "ProvisionedThroughputExceededException")
+		delay := backoffWithFullJitter(attempt)
+		if rad, ok := err.(retryAfterDelayer); ok {
+			if serverDelay, ok := rad.RetryAfter(); ok {
+				delay = serverDelay
 			}
 		}
 
-		if !retry {
-			break
+		log.Printf("Error requesting from Amazon: %v, retrying in %v\n", err, delay)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
 		}
+	}
+}
 
-		log.Printf("Retrying in %v ms\n", (1<<currentRetry)*50)
-		time.After((1 << currentRetry) * 50 * time.Millisecond)
-		currentRetry += 1
+// backoffWithFullJitter returns a random delay in [0, min(cap, base<<attempt))
+// per the "Exponential Backoff and Jitter" pattern.
+func backoffWithFullJitter(attempt uint) time.Duration {
+	ceiling := defaultMaxDelay
+	if attempt < 32 { // avoid overflowing the shift
+		if scaled := defaultBaseDelay << attempt; scaled > 0 && scaled < ceiling {
+			ceiling = scaled
+		}
 	}
+	return time.Duration(rand.Int63n(int64(ceiling)))
+}
+
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if awsErr, ok := err.(*Error); ok {
+		if awsErr.StatusCode >= 500 {
+			return true
+		}
+		switch awsErr.Code {
+		case "ThrottlingException",
+			"ProvisionedThroughputExceededException",
+			"ItemCollectionSizeLimitExceededException",
+			"LimitExceededException",
+			"RequestLimitExceeded":
+			return true
+		}
+		return false
+	}
+
+	if errors.Is(err, io.EOF) {
+		return true
+	}
+
+	if netErr, ok := err.(net.Error); ok {
+		return netErr.Temporary() || netErr.Timeout()
+	}
+
+	return false
+}
+
+// BasicRetry ==========
+
+// BasicRetry is kept for compatibility. It runs ExponentialBackoffRetry with
+// a budget of 5 calls (the initial call and 4 retries) and no wall-time limit.
+//
+// Deprecated: Use ExponentialBackoffRetry, or DefaultExponentialBackoffRetry,
+// instead.
+type BasicRetry struct{}
+
+func (br BasicRetry) Retry(ctx context.Context, exec func(context.Context) error) {
+	ExponentialBackoffRetry{MaxAttempts: maxNumberOfRetry + 1}.Retry(ctx, exec)
 }
 
 // SkipRetry ==========
 
 type SkipRetry struct{}
 
-func (sr SkipRetry) Retry(exec func() error) {
-	exec()
+func (sr SkipRetry) Retry(ctx context.Context, exec func(context.Context) error) {
+	exec(ctx)
 }
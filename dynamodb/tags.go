@@ -0,0 +1,70 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// TagT is a single resource tag, as used by TagResource, UntagResource and
+// returned by ListTagsOfResource.
+type TagT struct {
+	Key   string
+	Value string
+}
+
+type listTagsOfResourceResponse struct {
+	Tags      []TagT
+	NextToken string
+}
+
+// TagResource adds or overwrites the given tags on resourceArn, typically a
+// table's TableArn.
+func (s *Server) TagResource(resourceArn string, tags []TagT) error {
+	return s.TagResourceWithContext(context.Background(), resourceArn, tags)
+}
+
+func (s *Server) TagResourceWithContext(ctx context.Context, resourceArn string, tags []TagT) error {
+	query := NewEmptyQuery()
+	query.AddTagResource(resourceArn, tags)
+
+	_, err := s.queryServerWithContext(ctx, target("TagResource"), query)
+	return err
+}
+
+// UntagResource removes the given tag keys from resourceArn.
+func (s *Server) UntagResource(resourceArn string, tagKeys []string) error {
+	return s.UntagResourceWithContext(context.Background(), resourceArn, tagKeys)
+}
+
+func (s *Server) UntagResourceWithContext(ctx context.Context, resourceArn string, tagKeys []string) error {
+	query := NewEmptyQuery()
+	query.AddUntagResource(resourceArn, tagKeys)
+
+	_, err := s.queryServerWithContext(ctx, target("UntagResource"), query)
+	return err
+}
+
+// ListTagsOfResource returns one page of the tags set on resourceArn,
+// starting after nextToken (empty for the first page), along with the token
+// for the next page, which is empty once the last page has been returned.
+func (s *Server) ListTagsOfResource(resourceArn string, nextToken string) ([]TagT, string, error) {
+	return s.ListTagsOfResourceWithContext(context.Background(), resourceArn, nextToken)
+}
+
+func (s *Server) ListTagsOfResourceWithContext(ctx context.Context, resourceArn string, nextToken string) ([]TagT, string, error) {
+	query := NewEmptyQuery()
+	query.AddListTagsOfResource(resourceArn, nextToken)
+
+	jsonResponse, err := s.queryServerWithContext(ctx, target("ListTagsOfResource"), query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var r listTagsOfResourceResponse
+	err = json.Unmarshal(jsonResponse, &r)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return r.Tags, r.NextToken, nil
+}
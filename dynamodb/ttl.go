@@ -0,0 +1,84 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// TimeToLiveSpecificationT describes the TTL attribute to enable or disable
+// on a table, as used by UpdateTimeToLive.
+type TimeToLiveSpecificationT struct {
+	AttributeName string
+	Enabled       bool
+}
+
+// TimeToLiveDescriptionT describes the current TTL configuration of a table,
+// as returned by DescribeTimeToLive.
+type TimeToLiveDescriptionT struct {
+	AttributeName    string
+	TimeToLiveStatus string // one of "ENABLED", "DISABLED", "ENABLING", "DISABLING"
+}
+
+type describeTimeToLiveResponse struct {
+	TimeToLiveDescription TimeToLiveDescriptionT
+}
+
+// UpdateTimeToLive enables or disables TTL on attributeName for the named
+// table, so that items are automatically purged once the attribute's epoch
+// timestamp has passed.
+func (s *Server) UpdateTimeToLive(tableName, attributeName string, enabled bool) error {
+	return s.UpdateTimeToLiveWithContext(context.Background(), tableName, attributeName, enabled)
+}
+
+func (s *Server) UpdateTimeToLiveWithContext(ctx context.Context, tableName, attributeName string, enabled bool) error {
+	query := NewEmptyQuery()
+	query.AddUpdateTimeToLive(tableName, TimeToLiveSpecificationT{
+		AttributeName: attributeName,
+		Enabled:       enabled,
+	})
+
+	_, err := s.queryServerWithContext(ctx, target("UpdateTimeToLive"), query)
+	return err
+}
+
+// DescribeTimeToLive returns the current TTL configuration for the named
+// table.
+func (s *Server) DescribeTimeToLive(tableName string) (*TimeToLiveDescriptionT, error) {
+	return s.DescribeTimeToLiveWithContext(context.Background(), tableName)
+}
+
+func (s *Server) DescribeTimeToLiveWithContext(ctx context.Context, tableName string) (*TimeToLiveDescriptionT, error) {
+	query := NewEmptyQuery()
+	query.addTableByName(tableName)
+
+	jsonResponse, err := s.queryServerWithContext(ctx, target("DescribeTimeToLive"), query)
+	if err != nil {
+		return nil, err
+	}
+
+	var r describeTimeToLiveResponse
+	err = json.Unmarshal(jsonResponse, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.TimeToLiveDescription, nil
+}
+
+// UpdateTimeToLive enables or disables TTL on attributeName for this table.
+func (t *Table) UpdateTimeToLive(attributeName string, enabled bool) error {
+	return t.Server.UpdateTimeToLive(t.Name, attributeName, enabled)
+}
+
+func (t *Table) UpdateTimeToLiveWithContext(ctx context.Context, attributeName string, enabled bool) error {
+	return t.Server.UpdateTimeToLiveWithContext(ctx, t.Name, attributeName, enabled)
+}
+
+// DescribeTimeToLive returns the current TTL configuration for this table.
+func (t *Table) DescribeTimeToLive() (*TimeToLiveDescriptionT, error) {
+	return t.Server.DescribeTimeToLive(t.Name)
+}
+
+func (t *Table) DescribeTimeToLiveWithContext(ctx context.Context) (*TimeToLiveDescriptionT, error) {
+	return t.Server.DescribeTimeToLiveWithContext(ctx, t.Name)
+}